@@ -0,0 +1,40 @@
+// Command apizzad runs the apizza cart as a long-lived gRPC service so
+// that clients other than the cobra CLI (bots, web frontends, home
+// automation) can manage orders without shelling out.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/harrybrwn/apizza/cmd/cart"
+	"github.com/harrybrwn/apizza/rpc"
+	"github.com/harrybrwn/apizza/rpc/cartpb"
+)
+
+var addr = flag.String("addr", ":7457", "address for the gRPC server to listen on")
+
+func main() {
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("apizzad: %v", err)
+	}
+
+	c, err := cart.NewDefault()
+	if err != nil {
+		log.Fatalf("apizzad: %v", err)
+	}
+
+	srv := grpc.NewServer()
+	cartpb.RegisterCartServiceServer(srv, rpc.NewServer(c))
+
+	log.Printf("apizzad: listening on %s", *addr)
+	if err = srv.Serve(lis); err != nil {
+		log.Fatalf("apizzad: %v", err)
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/harrybrwn/apizza/cmd/cart"
 	"github.com/harrybrwn/apizza/cmd/internal/base"
 	"github.com/harrybrwn/apizza/cmd/internal/data"
 	"github.com/harrybrwn/apizza/cmd/internal/out"
@@ -102,14 +103,8 @@ func (c *cartCmd) Run(cmd *cobra.Command, args []string) (err error) {
 					return err
 				}
 			}
-		} else {
-			for _, newP := range c.add {
-				p, err := c.menu.GetVariant(newP)
-				if err != nil {
-					return err
-				}
-				order.AddProduct(p)
-			}
+		} else if err = cart.AddProducts(order, c.menu, c.add); err != nil {
+			return err
 		}
 		return data.SaveOrder(order, c.Output(), db)
 	}
@@ -162,10 +157,96 @@ created orders.`
 	// c.Flags().BoolVarP(&c.product, "product", "p", true, "change the state of --add and --remove to effect products in the order.")
 	c.Flags().StringVarP(&c.product, "product", "p", "", "give the product that will be effected by --add or --remove when --topping is specified.")
 	c.Flags().BoolVarP(&c.topping, "topping", "t", false, "change the state of --add and --remove to effect toppings in a product (see --product)")
-	c.Flags().StringSliceVarP(&c.add, "add", "a", c.add, "add any number of products to a specific order")
+	c.Flags().StringSliceVarP(&c.add, "add", "a", c.add, "add any number of products to a specific order, given as 'code' or 'code:qty'")
 	c.Flags().StringVarP(&c.remove, "remove", "r", c.remove, "remove a product from the order")
 
 	c.Flags().BoolVarP(&c.verbose, "verbose", "v", c.verbose, "print cart verbosly")
+
+	c.basecmd.Cmd().AddCommand(b.newCloneCmd().Cmd(), b.newTemplateCmd().Cmd())
+	return c
+}
+
+type cloneCmd struct {
+	*basecmd
+	cart *cart.Cart
+}
+
+func (c *cloneCmd) Run(cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return errors.New("usage: apizza cart clone <src order> <new order>")
+	}
+	if err := c.cart.CloneOrder(args[0], args[1]); err != nil {
+		return err
+	}
+	c.Printf("cloned '%s' to '%s'\n", args[0], args[1])
+	return nil
+}
+
+func (b *cliBuilder) newCloneCmd() base.CliCommand {
+	c := &cloneCmd{cart: cart.New(b)}
+	c.basecmd = b.newCommand("clone <src order> <new order>",
+		"Clone an existing order under a new name", c)
+	return c
+}
+
+type templateCmd struct {
+	*basecmd
+}
+
+func (c *templateCmd) Run(cmd *cobra.Command, args []string) error {
+	return data.PrintTemplates(db, c.Output())
+}
+
+type templateSaveCmd struct {
+	*basecmd
+	cart *cart.Cart
+}
+
+func (c *templateSaveCmd) Run(cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return errors.New("usage: apizza cart template save <order> <template name>")
+	}
+	if err := c.cart.SetCurrentOrder(args[0]); err != nil {
+		return err
+	}
+	if err := c.cart.SaveAsTemplate(args[1]); err != nil {
+		return err
+	}
+	c.Printf("saved '%s' as template '%s'\n", args[0], args[1])
+	return nil
+}
+
+type templateNewCmd struct {
+	*basecmd
+	cart *cart.Cart
+}
+
+func (c *templateNewCmd) Run(cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return errors.New("usage: apizza cart template new <template name> <new order>")
+	}
+	if err := c.cart.NewFromTemplate(args[0], args[1]); err != nil {
+		return err
+	}
+	c.Printf("created order '%s' from template '%s'\n", args[1], args[0])
+	return nil
+}
+
+func (b *cliBuilder) newTemplateCmd() base.CliCommand {
+	c := &templateCmd{}
+	c.basecmd = b.newCommand("template", "Manage reusable order templates", c)
+	c.basecmd.Cmd().Long = `The template command saves and re-uses "usual order"
+style templates, separately from the orders in your cart.`
+
+	save := &templateSaveCmd{cart: cart.New(b)}
+	save.basecmd = b.newCommand("save <order> <template name>",
+		"Save an existing order as a reusable template", save)
+
+	newCmd := &templateNewCmd{cart: cart.New(b)}
+	newCmd.basecmd = b.newCommand("new <template name> <new order>",
+		"Create a new order from a saved template", newCmd)
+
+	c.basecmd.Cmd().AddCommand(save.Cmd(), newCmd.Cmd())
 	return c
 }
 
@@ -190,17 +271,37 @@ func (c *addOrderCmd) Run(cmd *cobra.Command, args []string) (err error) {
 
 	if len(c.products) > 0 {
 		for i, p := range c.products {
-			prod, err := c.store().GetVariant(p)
+			code, qty, err := cart.ParseProductCode(p)
 			if err != nil {
 				return err
 			}
+			var topping string
 			if i < len(c.toppings) {
-				err = prod.AddTopping(c.toppings[i], dawg.ToppingFull, "1.0")
+				topping = c.toppings[i]
+			}
+			prod, err := newOrderProduct(c.store(), code, topping)
+			if err != nil {
+				return err
+			}
+			if err = order.AddProduct(prod); err != nil {
+				return err
+			}
+			if q, ok := prod.(cart.QuantitySetter); ok {
+				q.SetQty(qty)
+				continue
+			}
+			// no quantity support on this item, so fall back to adding a
+			// freshly fetched variant qty-1 more times, rather than
+			// reusing prod and aliasing every "extra" unit to one item.
+			for n := 1; n < qty; n++ {
+				extra, err := newOrderProduct(c.store(), code, topping)
 				if err != nil {
 					return err
 				}
+				if err = order.AddProduct(extra); err != nil {
+					return err
+				}
 			}
-			order.AddProduct(prod)
 		}
 	} else if len(c.toppings) > 0 {
 		return errors.New("cannot add just a toppings without products")
@@ -208,13 +309,28 @@ func (c *addOrderCmd) Run(cmd *cobra.Command, args []string) (err error) {
 	return data.SaveOrder(order, &bytes.Buffer{}, db)
 }
 
+// newOrderProduct fetches the variant for code from store and, if
+// topping is non-empty, adds it as a full topping.
+func newOrderProduct(store cart.VariantGetter, code, topping string) (dawg.Item, error) {
+	prod, err := store.GetVariant(code)
+	if err != nil {
+		return nil, err
+	}
+	if topping != "" {
+		if err = prod.AddTopping(topping, dawg.ToppingFull, "1.0"); err != nil {
+			return nil, err
+		}
+	}
+	return prod, nil
+}
+
 func (b *cliBuilder) newAddOrderCmd() base.CliCommand {
 	c := &addOrderCmd{name: "", products: []string{}}
 	c.basecmd = b.newCommand("add <new order name>",
 		"Create a new order that will be stored in the cart.", c)
 
 	c.Flags().StringVarP(&c.name, "name", "n", c.name, "set the name of a new order")
-	c.Flags().StringSliceVarP(&c.products, "products", "p", c.products, "product codes for the new order")
+	c.Flags().StringSliceVarP(&c.products, "products", "p", c.products, "product codes for the new order, given as 'code' or 'code:qty'")
 	c.Flags().StringSliceVarP(&c.toppings, "toppings", "t", c.toppings, "toppings for the products being added")
 	return c
 }
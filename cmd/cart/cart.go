@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -38,6 +39,17 @@ func New(b cli.Builder) *Cart {
 	}
 }
 
+// NewDefault builds a Cart from the user's default apizza config and
+// database, for use by things like cmd/apizzad that run outside of the
+// cobra command tree and so have no cli.Builder of their own.
+func NewDefault() (*Cart, error) {
+	b, err := cli.NewDefaultBuilder()
+	if err != nil {
+		return nil, err
+	}
+	return New(b), nil
+}
+
 var (
 	// ErrNoCurrentOrder tells when a method of the cart struct is called
 	// that requires the current order to be set but it cannot find one.
@@ -57,6 +69,7 @@ type Cart struct {
 
 	CurrentOrder *dawg.Order
 	out          io.Writer
+	events       eventBus
 }
 
 // SetCurrentOrder sets the order that the cart is currently working with.
@@ -65,6 +78,20 @@ func (c *Cart) SetCurrentOrder(name string) (err error) {
 	return err
 }
 
+// NewOrder creates a fresh order named name from the user's nearest
+// store and sets it as the current order. It does not save the order;
+// callers still need to call Save or SaveAndReset once it is populated.
+func (c *Cart) NewOrder(name string) error {
+	store, err := c.finder.Store()
+	if err != nil {
+		return err
+	}
+	order := store.NewOrder()
+	order.SetName(name)
+	c.CurrentOrder = order
+	return nil
+}
+
 // SetOutput sets the output of logging messages.
 func (c *Cart) SetOutput(w io.Writer) {
 	c.out = w
@@ -72,7 +99,11 @@ func (c *Cart) SetOutput(w io.Writer) {
 
 // DeleteOrder will delete an order from the database.
 func (c *Cart) DeleteOrder(name string) error {
-	return c.db.Delete(data.OrderPrefix + name)
+	if err := c.db.Delete(data.OrderPrefix + name); err != nil {
+		return err
+	}
+	c.events.publish(CartEvent{Type: OrderDeleted, Order: name})
+	return nil
 }
 
 // GetOrder will get an order from the database.
@@ -84,16 +115,27 @@ func (c *Cart) GetOrder(name string) (*dawg.Order, error) {
 	if len(raw) == 0 {
 		return nil, ErrOrderNotFound
 	}
-	order := &dawg.Order{}
-	order.Init()
-	order.SetName(name)
-	order.Address = dawg.StreetAddrFromAddress(c.finder.Address())
-	return order, json.Unmarshal(raw, order)
+	rec, err := data.DecodeOrderRecord(name, raw)
+	if err != nil {
+		return nil, err
+	}
+	rec.Order.Address = dawg.StreetAddrFromAddress(c.finder.Address())
+	return rec.Order, nil
 }
 
 // Save will save the current order and reset the current order.
 func (c *Cart) Save() error {
-	return data.SaveOrder(c.CurrentOrder, c.out, c.db)
+	name := c.CurrentOrder.Name()
+	raw, _ := c.db.Get(data.OrderPrefix + name)
+	if err := data.SaveOrder(c.CurrentOrder, c.out, c.db); err != nil {
+		return err
+	}
+	evt := OrderUpdated
+	if len(raw) == 0 {
+		evt = OrderCreated
+	}
+	c.events.publish(CartEvent{Type: evt, Order: name})
+	return nil
 }
 
 // SaveAndReset will save the order and set it to nil so that
@@ -142,6 +184,9 @@ func (c *Cart) Validate() error {
 	if dawg.IsWarning(err) {
 		return nil
 	}
+	if err == nil {
+		c.events.publish(CartEvent{Type: OrderValidated, Order: c.CurrentOrder.Name()})
+	}
 	fmt.Fprintln(c.out, "Order is ok.")
 	return err
 }
@@ -156,6 +201,9 @@ func (c *Cart) ValidateOrder(name string) error {
 	if dawg.IsWarning(err) {
 		return nil
 	}
+	if err == nil {
+		c.events.publish(CartEvent{Type: OrderValidated, Order: name})
+	}
 	return err
 }
 
@@ -164,7 +212,34 @@ func (c *Cart) AddToppings(product string, toppings []string) error {
 	if c.CurrentOrder == nil {
 		return ErrNoCurrentOrder
 	}
-	return addToppingsToOrder(c.CurrentOrder, product, toppings)
+	if err := addToppingsToOrder(c.CurrentOrder, product, toppings); err != nil {
+		return err
+	}
+	c.events.publish(CartEvent{Type: ToppingAdded, Order: c.CurrentOrder.Name(), Detail: product})
+	return nil
+}
+
+// SetQuantity sets how many of product are in the current order. It
+// returns an error if product isn't in the order or doesn't support
+// tracking a quantity.
+func (c *Cart) SetQuantity(product string, n int) error {
+	if c.CurrentOrder == nil {
+		return ErrNoCurrentOrder
+	}
+	if n < 1 {
+		return fmt.Errorf("quantity must be at least 1, got %d", n)
+	}
+	itm := getOrderItem(c.CurrentOrder, product)
+	if itm == nil {
+		return fmt.Errorf("cannot find '%s' in the '%s' order", product, c.CurrentOrder.Name())
+	}
+	q, ok := itm.(QuantitySetter)
+	if !ok {
+		return fmt.Errorf("%s does not support quantities greater than 1", product)
+	}
+	q.SetQty(n)
+	c.events.publish(CartEvent{Type: ProductAdded, Order: c.CurrentOrder.Name(), Detail: fmt.Sprintf("%s:%d", product, n)})
+	return nil
 }
 
 // AddProducts adds a list of products to the current order
@@ -175,7 +250,13 @@ func (c *Cart) AddProducts(products []string) error {
 	if err := c.db.UpdateTS("menu", c); err != nil {
 		return err
 	}
-	return addProducts(c.CurrentOrder, c.Menu(), products)
+	if err := AddProducts(c.CurrentOrder, c.Menu(), products); err != nil {
+		return err
+	}
+	for _, p := range products {
+		c.events.publish(CartEvent{Type: ProductAdded, Order: c.CurrentOrder.Name(), Detail: p})
+	}
+	return nil
 }
 
 // PrintOrders will print out all the orders saved in the database
@@ -183,6 +264,85 @@ func (c *Cart) PrintOrders(verbose bool) error {
 	return data.PrintOrders(c.db, c.out, verbose)
 }
 
+// StartCheckout moves a saved order into the pending state, meaning the
+// user has committed to placing it. Only orders that are still a draft
+// or have been validated can start checkout; see data.OrderRecord.
+func (c *Cart) StartCheckout(name string) error {
+	return data.StartCheckout(name, c.db)
+}
+
+// CompleteCheckout moves a pending order to placed, recording txID as
+// the transaction that confirmed it.
+func (c *Cart) CompleteCheckout(name, txID string) error {
+	if err := data.CompleteCheckout(name, txID, c.db); err != nil {
+		return err
+	}
+	c.events.publish(CartEvent{Type: OrderPlaced, Order: name, Detail: txID})
+	return nil
+}
+
+// CloneOrder copies the saved order src into a new saved order named
+// dst, as a fresh draft. If dst is already saved, its order is replaced
+// and its existing status is kept. src is left untouched.
+func (c *Cart) CloneOrder(src, dst string) error {
+	o, err := c.GetOrder(src)
+	if err != nil {
+		return err
+	}
+	cp, err := copyOrder(o, dst)
+	if err != nil {
+		return err
+	}
+	if err = data.SaveOrder(cp, c.out, c.db); err != nil {
+		return err
+	}
+	c.events.publish(CartEvent{Type: OrderCreated, Order: dst, Detail: "cloned from " + src})
+	return nil
+}
+
+// SaveAsTemplate saves the current order as a reusable template called
+// name, so NewFromTemplate can stamp out new orders from it later.
+func (c *Cart) SaveAsTemplate(name string) error {
+	if c.CurrentOrder == nil {
+		return ErrNoCurrentOrder
+	}
+	return data.SaveTemplate(c.CurrentOrder, name, c.db)
+}
+
+// NewFromTemplate creates and saves a new order named newName from the
+// template tmpl, and sets it as the current order.
+func (c *Cart) NewFromTemplate(tmpl, newName string) error {
+	t, err := data.GetTemplate(tmpl, c.db)
+	if err != nil {
+		return err
+	}
+	cp, err := copyOrder(t, newName)
+	if err != nil {
+		return err
+	}
+	if err = data.SaveOrder(cp, c.out, c.db); err != nil {
+		return err
+	}
+	c.CurrentOrder = cp
+	c.events.publish(CartEvent{Type: OrderCreated, Order: newName, Detail: "from template " + tmpl})
+	return nil
+}
+
+// copyOrder deep copies o (by round tripping through JSON, the same way
+// GetOrder decodes a saved order) and renames the copy to newName.
+func copyOrder(o *dawg.Order, newName string) (*dawg.Order, error) {
+	raw, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	cp := &dawg.Order{}
+	if err = json.Unmarshal(raw, cp); err != nil {
+		return nil, err
+	}
+	cp.SetName(newName)
+	return cp, nil
+}
+
 func addToppingsToOrder(o *dawg.Order, product string, toppings []string) (err error) {
 	if product == "" {
 		return errors.New("what product are these toppings being added to")
@@ -201,17 +361,72 @@ func addToppingsToOrder(o *dawg.Order, product string, toppings []string) (err e
 	return nil
 }
 
-func addProducts(o *dawg.Order, menu *dawg.Menu, products []string) (err error) {
+// QuantitySetter is implemented by dawg items that can track how many of
+// themselves are in an order; not every dawg.Item does.
+type QuantitySetter interface {
+	SetQty(n int)
+}
+
+// VariantGetter looks up a dawg.Item variant by its product code. Both
+// *dawg.Menu and *dawg.Store satisfy it, so AddProducts works whether a
+// caller has a menu cache handy or is going straight to the store.
+type VariantGetter interface {
+	GetVariant(code string) (dawg.Item, error)
+}
+
+// ParseProductCode splits a "code:qty" string (as accepted by
+// AddProducts and the --add flags) into the bare product code and the
+// requested quantity. A code with no ":qty" suffix means a quantity of 1.
+func ParseProductCode(s string) (code string, qty int, err error) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) == 1 {
+		return parts[0], 1, nil
+	}
+	qty, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("bad quantity in %q: %v", s, err)
+	}
+	if qty < 1 {
+		return "", 0, fmt.Errorf("quantity must be at least 1, got %d", qty)
+	}
+	return parts[0], qty, nil
+}
+
+// AddProducts parses each entry of products as a "code" or "code:qty"
+// string and adds it to o, using variants to look up each product code.
+// A quantity greater than 1 is tracked with QuantitySetter.SetQty when the
+// item supports it, and otherwise falls back to adding a freshly fetched
+// variant qty-1 more times so that separate units don't alias the same
+// dawg.Item instance.
+func AddProducts(o *dawg.Order, variants VariantGetter, products []string) (err error) {
 	var itm dawg.Item
 	for _, newP := range products {
-		itm, err = menu.GetVariant(newP)
+		code, qty, err := ParseProductCode(newP)
 		if err != nil {
 			return err
 		}
-		err = o.AddProduct(itm)
-		if err != nil {
+		if itm, err = variants.GetVariant(code); err != nil {
+			return err
+		}
+		if err = o.AddProduct(itm); err != nil {
 			return err
 		}
+		if q, ok := itm.(QuantitySetter); ok {
+			// the item tracks its own quantity, so one AddProduct call
+			// plus SetQty is enough.
+			q.SetQty(qty)
+			continue
+		}
+		// no quantity support on this item, so fall back to adding it
+		// qty-1 more times.
+		for i := 1; i < qty; i++ {
+			if itm, err = variants.GetVariant(code); err != nil {
+				return err
+			}
+			if err = o.AddProduct(itm); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
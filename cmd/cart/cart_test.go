@@ -0,0 +1,34 @@
+package cart
+
+import "testing"
+
+func TestParseProductCode(t *testing.T) {
+	cases := []struct {
+		in      string
+		code    string
+		qty     int
+		wantErr bool
+	}{
+		{in: "14SCREEN", code: "14SCREEN", qty: 1},
+		{in: "14SCREEN:3", code: "14SCREEN", qty: 3},
+		{in: "14SCREEN:0", wantErr: true},
+		{in: "14SCREEN:-1", wantErr: true},
+		{in: "14SCREEN:abc", wantErr: true},
+	}
+	for _, c := range cases {
+		code, qty, err := ParseProductCode(c.in)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("ParseProductCode(%q): expected an error", c.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseProductCode(%q): %v", c.in, err)
+			continue
+		}
+		if code != c.code || qty != c.qty {
+			t.Errorf("ParseProductCode(%q) = (%q, %d), want (%q, %d)", c.in, code, qty, c.code, c.qty)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package cart
+
+import (
+	"context"
+	"sync"
+)
+
+// EventType identifies what kind of mutation a CartEvent describes.
+type EventType string
+
+// The full set of events a Cart can emit. OrderCreated, OrderUpdated,
+// OrderDeleted and OrderPlaced are emitted after the database write
+// succeeds. ProductAdded and ToppingAdded are emitted when the cart's
+// current order changes in memory, before it is saved. OrderValidated
+// is emitted when validation passes and involves no write at all.
+const (
+	OrderCreated   EventType = "order_created"
+	OrderUpdated   EventType = "order_updated"
+	ProductAdded   EventType = "product_added"
+	ToppingAdded   EventType = "topping_added"
+	OrderDeleted   EventType = "order_deleted"
+	OrderValidated EventType = "order_validated"
+	OrderPlaced    EventType = "order_placed"
+)
+
+// CartEvent describes a single mutation made to a saved order.
+type CartEvent struct {
+	Type  EventType `json:"type"`
+	Order string    `json:"order"`
+	// Detail is a short human readable description of what changed,
+	// e.g. the product code that was added.
+	Detail string `json:"detail,omitempty"`
+}
+
+// Sink receives every CartEvent a Cart emits. Subscribe is the in-process
+// sink; anything else (Redis, a log file, a webhook) can implement Sink
+// and be registered with AddSink.
+type Sink interface {
+	Notify(CartEvent)
+}
+
+// eventBus fans a single CartEvent out to any number of channel
+// subscribers and Sinks. The zero value is ready to use.
+type eventBus struct {
+	mu    sync.Mutex
+	chans map[chan CartEvent]struct{}
+	sinks []Sink
+}
+
+func (b *eventBus) publish(e CartEvent) {
+	b.mu.Lock()
+	for ch := range b.chans {
+		select {
+		case ch <- e:
+		default:
+			// drop the event rather than block the caller on a slow reader
+		}
+	}
+	sinks := make([]Sink, len(b.sinks))
+	copy(sinks, b.sinks)
+	b.mu.Unlock()
+
+	// Sinks (e.g. RedisSink) can do blocking network I/O in Notify, and a
+	// Cart is shared across every concurrent gRPC request in apizzad. Fan
+	// each one out in its own goroutine instead of notifying them
+	// serially while holding b.mu, so a slow or unreachable sink can't
+	// stall every other order mutation.
+	for _, s := range sinks {
+		go s.Notify(e)
+	}
+}
+
+func (b *eventBus) subscribe(ctx context.Context) <-chan CartEvent {
+	ch := make(chan CartEvent, 8)
+	b.mu.Lock()
+	if b.chans == nil {
+		b.chans = make(map[chan CartEvent]struct{})
+	}
+	b.chans[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.chans, ch)
+		b.mu.Unlock()
+		close(ch)
+	}()
+	return ch
+}
+
+func (b *eventBus) addSink(s Sink) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sinks = append(b.sinks, s)
+}
+
+// Subscribe returns a channel that receives every CartEvent the cart
+// emits until ctx is done, at which point the channel is closed. This is
+// meant for in-process consumers such as CLI hooks or tests; for an
+// out-of-process subscriber see AddSink and NewRedisSink.
+func (c *Cart) Subscribe(ctx context.Context) <-chan CartEvent {
+	return c.events.subscribe(ctx)
+}
+
+// AddSink registers s to receive every CartEvent this cart emits, in
+// addition to any channel subscribers. Use this to wire up a
+// RedisSink or similar out-of-process publisher.
+func (c *Cart) AddSink(s Sink) {
+	c.events.addSink(s)
+}
@@ -0,0 +1,74 @@
+package cart
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockingSink never returns from Notify until released, so a test can
+// assert that publish doesn't wait on it.
+type blockingSink struct {
+	release chan struct{}
+	got     chan CartEvent
+}
+
+func (s *blockingSink) Notify(e CartEvent) {
+	<-s.release
+	s.got <- e
+}
+
+func TestEventBusPublishDoesNotBlockOnSink(t *testing.T) {
+	b := &eventBus{}
+	sink := &blockingSink{release: make(chan struct{}), got: make(chan CartEvent, 1)}
+	b.addSink(sink)
+
+	done := make(chan struct{})
+	go func() {
+		b.publish(CartEvent{Type: OrderCreated, Order: "margherita"})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publish blocked on a sink that hadn't been released yet")
+	}
+
+	close(sink.release)
+	select {
+	case e := <-sink.got:
+		if e.Order != "margherita" {
+			t.Errorf("sink got event for %q, want %q", e.Order, "margherita")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("sink was never notified")
+	}
+}
+
+func TestEventBusPublishFansOutToAllSinks(t *testing.T) {
+	b := &eventBus{}
+	var wg sync.WaitGroup
+	const n = 5
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		b.addSink(Sink(notifyFunc(func(CartEvent) { wg.Done() })))
+	}
+	b.publish(CartEvent{Type: ProductAdded, Order: "margherita"})
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("not every sink was notified")
+	}
+}
+
+// notifyFunc adapts a plain func to the Sink interface.
+type notifyFunc func(CartEvent)
+
+func (f notifyFunc) Notify(e CartEvent) { f(e) }
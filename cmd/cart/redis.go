@@ -0,0 +1,74 @@
+package cart
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisSink publishes CartEvents to a per-user redis pub/sub channel so
+// that other processes can watch a user's cart without polling the bolt
+// database. It implements Sink and is meant to be registered with
+// Cart.AddSink.
+type RedisSink struct {
+	client *redis.Client
+	ctx    context.Context
+	// channel is the redis pub/sub channel events are published on,
+	// usually something like "apizza:cart:<user>".
+	channel string
+	errOut  io.Writer
+}
+
+// NewRedisSink returns a RedisSink that publishes to channel on client.
+func NewRedisSink(client *redis.Client, channel string) *RedisSink {
+	return &RedisSink{client: client, ctx: context.Background(), channel: channel, errOut: os.Stderr}
+}
+
+// SetErrorOutput sets where Notify logs a failed publish, in place of the
+// os.Stderr default.
+func (r *RedisSink) SetErrorOutput(w io.Writer) {
+	r.errOut = w
+}
+
+// Notify marshals e to JSON and publishes it on the sink's channel. Any
+// error from redis is swallowed other than being logged, since a failed
+// notification should never break the caller's order mutation.
+func (r *RedisSink) Notify(e CartEvent) {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	if err = r.client.Publish(r.ctx, r.channel, raw).Err(); err != nil {
+		fmt.Fprintf(r.errOut, "apizza: failed to publish cart event: %v\n", err)
+	}
+}
+
+// SubscribeRedis listens on channel and decodes every message as a
+// CartEvent, forwarding it to fn until ctx is cancelled. This is the
+// out-of-process mirror of Cart.Subscribe for clients that aren't in the
+// same process as the Cart.
+func SubscribeRedis(ctx context.Context, client *redis.Client, channel string, fn func(CartEvent)) error {
+	sub := client.Subscribe(ctx, channel)
+	defer sub.Close()
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			var e CartEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &e); err != nil {
+				continue
+			}
+			fn(e)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+// Command client is a minimal example of talking to apizzad over gRPC
+// instead of going through the apizza cobra CLI.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/harrybrwn/apizza/rpc/cartpb"
+)
+
+var (
+	addr  = flag.String("addr", "localhost:7457", "address of the apizzad server")
+	order = flag.String("order", "", "name of the order to fetch")
+)
+
+func main() {
+	flag.Parse()
+	if *order == "" {
+		log.Fatal("client: no -order given")
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("client: %v", err)
+	}
+	defer conn.Close()
+
+	c := cartpb.NewCartServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	o, err := c.GetOrder(ctx, &cartpb.OrderRequest{Name: *order})
+	if err != nil {
+		log.Fatalf("client: %v", err)
+	}
+	log.Printf("order %q: %v", o.Name, o.ProductCodes)
+}
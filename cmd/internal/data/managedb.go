@@ -2,61 +2,281 @@ package data
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/harrybrwn/apizza/dawg"
 	"github.com/harrybrwn/apizza/pkg/cache"
 )
 
+// ErrRecordNotFound is wrapped into the error GetOrderRecord returns when
+// no record is saved under the given name, so callers like SaveOrder can
+// tell "doesn't exist yet" apart from a genuine database error.
+var ErrRecordNotFound = errors.New("no such order record")
+
 // OrderPrefix is the prefix added to user orders when stored in a database.
 const OrderPrefix = "user_order_"
 
-// PrintOrders will print all the names of the saved user orders
-func PrintOrders(db cache.MapDB, w io.Writer) error {
+// GetPutter is the minimal set of database operations needed to read and
+// write an OrderRecord back to back, as SaveOrder, StartCheckout and
+// CompleteCheckout all do.
+type GetPutter interface {
+	cache.Getter
+	cache.Putter
+}
+
+// OrderStatus tracks where a saved order is in its lifecycle, from being
+// a local draft to an order that has actually been placed and paid for.
+type OrderStatus string
+
+// The full set of states an OrderRecord can be in. Not every transition
+// between these is legal; see OrderRecord.transition.
+const (
+	// StatusDraft is a saved order that has never been validated or sent
+	// anywhere. This is the status every newly saved order starts in.
+	StatusDraft OrderStatus = "draft"
+	// StatusValidated means the order passed dawg.Order.Validate.
+	StatusValidated OrderStatus = "validated"
+	// StatusPending means checkout has started but the order has not
+	// been confirmed placed with the store yet.
+	StatusPending OrderStatus = "pending"
+	// StatusPlaced means the store has accepted the order.
+	StatusPlaced OrderStatus = "placed"
+	// StatusPaid means a payment transaction completed for the order.
+	StatusPaid OrderStatus = "paid"
+	// StatusCancelled means the user backed out of the order.
+	StatusCancelled OrderStatus = "cancelled"
+	// StatusFailed means placing or paying for the order errored out.
+	StatusFailed OrderStatus = "failed"
+)
+
+// legalTransitions lists, for each status, the set of statuses that may
+// follow it. Anything not in this map (or not present in its value) is
+// rejected by OrderRecord.transition.
+//
+// StatusDraft moves straight to StatusPending as well as StatusValidated:
+// nothing persists StatusValidated until a caller explicitly saves an
+// OrderRecord in that state, so gating StartCheckout on it would make
+// checkout unreachable for the vast majority of saved orders, which are
+// validated in memory (Cart.Validate, the PlaceOrder RPC) right before
+// checkout starts rather than validated-then-saved-then-checked-out.
+var legalTransitions = map[OrderStatus][]OrderStatus{
+	StatusDraft:     {StatusValidated, StatusPending, StatusCancelled},
+	StatusValidated: {StatusDraft, StatusPending, StatusCancelled},
+	StatusPending:   {StatusPlaced, StatusFailed, StatusCancelled},
+	StatusPlaced:    {StatusPaid, StatusFailed},
+	StatusFailed:    {StatusDraft},
+}
+
+// OrderRecord is the first-class representation of a saved order. It
+// wraps a dawg.Order with the status and bookkeeping apizza needs to
+// know whether an order sitting in the database is still a draft or was
+// actually placed and paid for.
+type OrderRecord struct {
+	Order         *dawg.Order `json:"order"`
+	Status        OrderStatus `json:"status"`
+	TransactionID string      `json:"transaction_id,omitempty"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+// newOrderRecord wraps o as a fresh draft record.
+func newOrderRecord(o *dawg.Order) *OrderRecord {
+	now := time.Now()
+	return &OrderRecord{Order: o, Status: StatusDraft, CreatedAt: now, UpdatedAt: now}
+}
+
+// transition moves the record to status if that move is legal, erroring
+// otherwise. It always updates UpdatedAt on success.
+func (r *OrderRecord) transition(status OrderStatus) error {
+	for _, next := range legalTransitions[r.Status] {
+		if next == status {
+			r.Status = status
+			r.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot move order %q from %q to %q", r.Order.Name(), r.Status, status)
+}
+
+// PrintOrders will print all the names of the saved user orders. With
+// verbose set, it also prints each order's status.
+func PrintOrders(db cache.MapDB, w io.Writer, verbose bool) error {
 	all, err := db.Map()
 	if err != nil {
 		return err
 	}
-	var orders []string
+	var records []*OrderRecord
 
-	for k := range all {
-		if strings.Contains(k, OrderPrefix) {
-			orders = append(orders, strings.Replace(k, OrderPrefix, "", -1))
+	for k, raw := range all {
+		if !strings.Contains(k, OrderPrefix) {
+			continue
 		}
+		rec, err := DecodeOrderRecord(strings.Replace(k, OrderPrefix, "", -1), raw)
+		if err != nil {
+			return err
+		}
+		records = append(records, rec)
 	}
-	if len(orders) < 1 {
+	if len(records) < 1 {
 		fmt.Fprintln(w, "No orders saved.")
 		return nil
 	}
 	fmt.Fprintln(w, "Your Orders:")
-	for _, o := range orders {
-		fmt.Fprintln(w, " ", o)
+	for _, r := range records {
+		if verbose {
+			fmt.Fprintf(w, "  %-20s %s\n", r.Order.Name(), r.Status)
+		} else {
+			fmt.Fprintln(w, " ", r.Order.Name())
+		}
 	}
 	return nil
 }
 
+// GetOrder retrieves a saved order by name, ignoring its status.
 func GetOrder(name string, db cache.Getter) (*dawg.Order, error) {
+	rec, err := GetOrderRecord(name, db)
+	if err != nil {
+		return nil, err
+	}
+	return rec.Order, nil
+}
+
+// GetOrderRecord retrieves the full OrderRecord (order plus status and
+// timestamps) for a saved order.
+func GetOrderRecord(name string, db cache.Getter) (*OrderRecord, error) {
 	raw, err := db.Get(OrderPrefix + name)
 	if err != nil {
 		return nil, err
 	}
 	if raw == nil {
-		return nil, fmt.Errorf("cannot find order %s", name)
+		return nil, fmt.Errorf("cannot find order %s: %w", name, ErrRecordNotFound)
 	}
-	order := &dawg.Order{}
-	if err = json.Unmarshal(raw, order); err != nil {
+	return DecodeOrderRecord(name, raw)
+}
+
+// DecodeOrderRecord unmarshals raw (as stored by putOrderRecord) into an
+// OrderRecord, stamping its Order with name since the order's own name
+// isn't part of the stored JSON.
+func DecodeOrderRecord(name string, raw []byte) (*OrderRecord, error) {
+	rec := &OrderRecord{Order: &dawg.Order{}}
+	if err := json.Unmarshal(raw, rec); err != nil {
 		return nil, err
 	}
-	order.SetName(name)
-	return order, nil
+	if rec.Order == nil {
+		rec.Order = &dawg.Order{}
+	}
+	rec.Order.SetName(name)
+	return rec, nil
+}
+
+// SaveOrder persists o to db, preserving its existing status and
+// timestamps if it was already saved, or creating a fresh draft record
+// if this is the first time o has been saved.
+func SaveOrder(o *dawg.Order, w io.Writer, db GetPutter) error {
+	rec, err := GetOrderRecord(o.Name(), db)
+	switch {
+	case errors.Is(err, ErrRecordNotFound):
+		rec = newOrderRecord(o)
+	case err != nil:
+		return err
+	default:
+		rec.Order = o
+		rec.UpdatedAt = time.Now()
+	}
+	return putOrderRecord(rec, db)
+}
+
+// StartCheckout moves a saved order from draft/validated into pending,
+// the state that means "checkout is in progress but not confirmed".
+func StartCheckout(name string, db GetPutter) error {
+	rec, err := GetOrderRecord(name, db)
+	if err != nil {
+		return err
+	}
+	if err = rec.transition(StatusPending); err != nil {
+		return err
+	}
+	return putOrderRecord(rec, db)
+}
+
+// CompleteCheckout moves a pending order to placed and records the
+// payment transaction id that confirmed it.
+func CompleteCheckout(name, txID string, db GetPutter) error {
+	rec, err := GetOrderRecord(name, db)
+	if err != nil {
+		return err
+	}
+	if err = rec.transition(StatusPlaced); err != nil {
+		return err
+	}
+	rec.TransactionID = txID
+	return putOrderRecord(rec, db)
 }
 
-func SaveOrder(o *dawg.Order, db cache.Putter) error {
+func putOrderRecord(rec *OrderRecord, db cache.Putter) error {
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return db.Put(OrderPrefix+rec.Order.Name(), raw)
+}
+
+// TemplatePrefix is the prefix added to saved order templates, parallel
+// to OrderPrefix for regular saved orders.
+const TemplatePrefix = "user_template_"
+
+// SaveTemplate saves o under name as a reusable template. Unlike
+// SaveOrder, a template has no status or checkout history; it is just a
+// named product list that NewFromTemplate-style callers stamp out fresh
+// orders from.
+func SaveTemplate(o *dawg.Order, name string, db cache.Putter) error {
 	raw, err := json.Marshal(o)
 	if err != nil {
 		return err
 	}
-	return db.Put(OrderPrefix+o.Name(), raw)
-}
\ No newline at end of file
+	return db.Put(TemplatePrefix+name, raw)
+}
+
+// GetTemplate retrieves a saved template by name.
+func GetTemplate(name string, db cache.Getter) (*dawg.Order, error) {
+	raw, err := db.Get(TemplatePrefix + name)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("cannot find template %s", name)
+	}
+	o := &dawg.Order{}
+	if err = json.Unmarshal(raw, o); err != nil {
+		return nil, err
+	}
+	o.SetName(name)
+	return o, nil
+}
+
+// PrintTemplates prints the names of all saved order templates.
+func PrintTemplates(db cache.MapDB, w io.Writer) error {
+	all, err := db.Map()
+	if err != nil {
+		return err
+	}
+	var names []string
+	for k := range all {
+		if strings.Contains(k, TemplatePrefix) {
+			names = append(names, strings.Replace(k, TemplatePrefix, "", -1))
+		}
+	}
+	if len(names) < 1 {
+		fmt.Fprintln(w, "No templates saved.")
+		return nil
+	}
+	fmt.Fprintln(w, "Your Templates:")
+	for _, n := range names {
+		fmt.Fprintln(w, " ", n)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package data
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/harrybrwn/apizza/dawg"
+)
+
+// memGetPutter is a minimal in-memory GetPutter for exercising the
+// OrderRecord save/get round trip without a real bolt-backed database.
+type memGetPutter map[string][]byte
+
+func (m memGetPutter) Get(key string) ([]byte, error) { return m[key], nil }
+func (m memGetPutter) Put(key string, raw []byte) error {
+	m[key] = raw
+	return nil
+}
+
+// memMapDB extends memGetPutter with Map, for exercising the cache.MapDB
+// functions (PrintOrders, PrintTemplates) without a real bolt database.
+type memMapDB struct{ memGetPutter }
+
+func (m memMapDB) Map() (map[string][]byte, error) { return m.memGetPutter, nil }
+
+func TestSaveOrderRoundTrip(t *testing.T) {
+	db := memGetPutter{}
+	o := &dawg.Order{}
+	o.SetName("margherita")
+
+	if err := SaveOrder(o, io.Discard, db); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err := GetOrderRecord("margherita", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Order.Name() != "margherita" {
+		t.Errorf("expected order name %q, got %q", "margherita", rec.Order.Name())
+	}
+	if rec.Status != StatusDraft {
+		t.Errorf("a freshly saved order should be a draft, got %q", rec.Status)
+	}
+
+	// Moving the record out of draft and saving the order again should
+	// not clobber the status or the record already in the database.
+	if err = rec.transition(StatusValidated); err != nil {
+		t.Fatal(err)
+	}
+	if err = putOrderRecord(rec, db); err != nil {
+		t.Fatal(err)
+	}
+	if err = SaveOrder(o, io.Discard, db); err != nil {
+		t.Fatal(err)
+	}
+
+	rec2, err := GetOrderRecord("margherita", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec2.Status != StatusValidated {
+		t.Errorf("SaveOrder clobbered an existing status, got %q", rec2.Status)
+	}
+}
+
+func TestGetOrderRecordNotFound(t *testing.T) {
+	_, err := GetOrderRecord("nope", memGetPutter{})
+	if err == nil {
+		t.Fatal("expected an error for a missing record")
+	}
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("expected the error to wrap ErrRecordNotFound, got %v", err)
+	}
+}
+
+func TestCheckoutLifecycle(t *testing.T) {
+	db := memGetPutter{}
+	o := &dawg.Order{}
+	o.SetName("margherita")
+	if err := SaveOrder(o, io.Discard, db); err != nil {
+		t.Fatal(err)
+	}
+
+	// A freshly saved order is still a draft; StartCheckout must be able
+	// to move it straight to pending without ever visiting validated.
+	if err := StartCheckout("margherita", db); err != nil {
+		t.Fatalf("StartCheckout on a draft order: %v", err)
+	}
+	rec, err := GetOrderRecord("margherita", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Status != StatusPending {
+		t.Errorf("expected status %q after StartCheckout, got %q", StatusPending, rec.Status)
+	}
+
+	if err = CompleteCheckout("margherita", "tx-123", db); err != nil {
+		t.Fatalf("CompleteCheckout on a pending order: %v", err)
+	}
+	rec, err = GetOrderRecord("margherita", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Status != StatusPlaced {
+		t.Errorf("expected status %q after CompleteCheckout, got %q", StatusPlaced, rec.Status)
+	}
+	if rec.TransactionID != "tx-123" {
+		t.Errorf("expected transaction id %q, got %q", "tx-123", rec.TransactionID)
+	}
+}
+
+func TestSaveAndGetTemplate(t *testing.T) {
+	db := memGetPutter{}
+	o := &dawg.Order{}
+	o.SetName("usual")
+
+	if err := SaveTemplate(o, "usual-order", db); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := GetTemplate("usual-order", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name() != "usual-order" {
+		t.Errorf("expected template to be stamped with its own name %q, got %q", "usual-order", got.Name())
+	}
+
+	if _, err = GetTemplate("nope", db); err == nil {
+		t.Fatal("expected an error for a missing template")
+	}
+}
+
+func TestPrintTemplates(t *testing.T) {
+	db := memMapDB{memGetPutter{}}
+	var out strings.Builder
+
+	if err := PrintTemplates(db, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "No templates saved") {
+		t.Errorf("expected a no-templates message, got %q", out.String())
+	}
+
+	o := &dawg.Order{}
+	o.SetName("usual")
+	if err := SaveTemplate(o, "usual-order", db); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err := PrintTemplates(db, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "usual-order") {
+		t.Errorf("expected output to list %q, got %q", "usual-order", out.String())
+	}
+}
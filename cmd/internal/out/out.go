@@ -0,0 +1,45 @@
+// Package out holds the printing helpers shared by the cart commands, so
+// that an order gets formatted the same way whether it's being shown
+// from 'apizza cart' or right after an add/remove.
+package out
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/harrybrwn/apizza/dawg"
+)
+
+// quantifiable is implemented by dawg items that track how many of
+// themselves are in an order; not every dawg.Item does.
+type quantifiable interface {
+	Qty() int
+}
+
+// PrintOrder prints o's name and, if showProducts is set, every product
+// in it (with quantities, for items that track them). If showPrice is
+// set the order's price is printed as well.
+func PrintOrder(o *dawg.Order, showProducts, showPrice bool) error {
+	fmt.Printf("%s:\n", o.Name())
+	if showProducts {
+		for _, p := range o.Products {
+			qty := 1
+			if q, ok := p.(quantifiable); ok && q.Qty() > 0 {
+				qty = q.Qty()
+			}
+			if qty > 1 {
+				fmt.Fprintf(os.Stdout, "  %s x%d\n", p.ItemCode(), qty)
+			} else {
+				fmt.Fprintf(os.Stdout, "  %s\n", p.ItemCode())
+			}
+		}
+	}
+	if showPrice {
+		price, err := o.Price()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("price: $%.2f\n", price)
+	}
+	return nil
+}
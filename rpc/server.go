@@ -0,0 +1,153 @@
+// Package rpc implements a gRPC front end for cart.Cart so that apizza's
+// persisted orders can be driven by something other than the cobra CLI.
+//
+// The message types come from cart.proto; run `go generate ./rpc` (or see
+// the comment at the top of cart.proto) to regenerate rpc/cartpb after
+// editing the schema.
+package rpc
+
+//go:generate protoc -I=. --go_out=cartpb --go_opt=paths=source_relative --go-grpc_out=cartpb --go-grpc_opt=paths=source_relative cart.proto
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harrybrwn/apizza/cmd/cart"
+	"github.com/harrybrwn/apizza/dawg"
+	"github.com/harrybrwn/apizza/rpc/cartpb"
+)
+
+// Server implements cartpb.CartServiceServer on top of an existing
+// cart.Cart, so it shares the same bolt-backed cache.DataBase the CLI uses.
+type Server struct {
+	cartpb.UnimplementedCartServiceServer
+	cart *cart.Cart
+}
+
+// NewServer wraps c in a gRPC CartServiceServer.
+func NewServer(c *cart.Cart) *Server {
+	return &Server{cart: c}
+}
+
+// AddOrder creates a new order from a list of product codes. If an order
+// with that name is already saved, the products are added to it instead.
+func (s *Server) AddOrder(ctx context.Context, req *cartpb.AddOrderRequest) (*cartpb.Order, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("rpc: order needs a name")
+	}
+	if err := s.cart.SetCurrentOrder(req.Name); err != nil {
+		if err != cart.ErrOrderNotFound {
+			return nil, err
+		}
+		if err = s.cart.NewOrder(req.Name); err != nil {
+			return nil, err
+		}
+	}
+	if len(req.Products) > 0 {
+		if err := s.cart.AddProducts(req.Products); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.cart.SaveAndReset(); err != nil {
+		return nil, err
+	}
+	return s.GetOrder(ctx, &cartpb.OrderRequest{Name: req.Name})
+}
+
+// ListOrders returns the names of every order saved in the cart.
+func (s *Server) ListOrders(ctx context.Context, req *cartpb.ListOrdersRequest) (*cartpb.ListOrdersReply, error) {
+	names, err := s.cart.ListOrders()
+	if err != nil {
+		return nil, err
+	}
+	return &cartpb.ListOrdersReply{Names: names}, nil
+}
+
+// GetOrder fetches a single saved order by name.
+func (s *Server) GetOrder(ctx context.Context, req *cartpb.OrderRequest) (*cartpb.Order, error) {
+	order, err := s.cart.GetOrder(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return orderToPB(order)
+}
+
+// DeleteOrder removes an order from the cart's database.
+func (s *Server) DeleteOrder(ctx context.Context, req *cartpb.OrderRequest) (*cartpb.DeleteOrderReply, error) {
+	if err := s.cart.DeleteOrder(req.Name); err != nil {
+		return nil, err
+	}
+	return &cartpb.DeleteOrderReply{Name: req.Name}, nil
+}
+
+// AddProducts adds products to an existing order and saves it.
+func (s *Server) AddProducts(ctx context.Context, req *cartpb.AddProductsRequest) (*cartpb.Order, error) {
+	if err := s.cart.SetCurrentOrder(req.Name); err != nil {
+		return nil, err
+	}
+	if err := s.cart.AddProducts(req.Products); err != nil {
+		return nil, err
+	}
+	if err := s.cart.SaveAndReset(); err != nil {
+		return nil, err
+	}
+	return s.GetOrder(ctx, &cartpb.OrderRequest{Name: req.Name})
+}
+
+// AddToppings adds toppings to a product already in an order and saves it.
+func (s *Server) AddToppings(ctx context.Context, req *cartpb.AddToppingsRequest) (*cartpb.Order, error) {
+	if err := s.cart.SetCurrentOrder(req.Name); err != nil {
+		return nil, err
+	}
+	if err := s.cart.AddToppings(req.Product, req.Toppings); err != nil {
+		return nil, err
+	}
+	if err := s.cart.SaveAndReset(); err != nil {
+		return nil, err
+	}
+	return s.GetOrder(ctx, &cartpb.OrderRequest{Name: req.Name})
+}
+
+// ValidateOrder checks an order against the dominos api without placing it.
+func (s *Server) ValidateOrder(ctx context.Context, req *cartpb.OrderRequest) (*cartpb.ValidateOrderReply, error) {
+	err := s.cart.ValidateOrder(req.Name)
+	if err != nil {
+		return &cartpb.ValidateOrderReply{Ok: false, Message: err.Error()}, nil
+	}
+	return &cartpb.ValidateOrderReply{Ok: true}, nil
+}
+
+// PlaceOrder validates an order, starts checkout, places it with the
+// store and records the resulting transaction id.
+func (s *Server) PlaceOrder(ctx context.Context, req *cartpb.OrderRequest) (*cartpb.PlaceOrderReply, error) {
+	order, err := s.cart.GetOrder(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err = order.Validate(); err != nil && !dawg.IsWarning(err) {
+		return nil, err
+	}
+	if err = s.cart.StartCheckout(req.Name); err != nil {
+		return nil, err
+	}
+	if err = order.PlaceOrder(); err != nil {
+		return nil, err
+	}
+	txID := order.TransactionID()
+	if err = s.cart.CompleteCheckout(req.Name, txID); err != nil {
+		return nil, err
+	}
+	return &cartpb.PlaceOrderReply{Name: req.Name, TransactionId: txID}, nil
+}
+
+func orderToPB(o *dawg.Order) (*cartpb.Order, error) {
+	codes := make([]string, 0, len(o.Products))
+	for _, p := range o.Products {
+		codes = append(codes, p.ItemCode())
+	}
+	price, err := o.Price()
+	if err != nil {
+		return nil, err
+	}
+	return &cartpb.Order{Name: o.Name(), ProductCodes: codes, Price: price}, nil
+}